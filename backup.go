@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"io"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// DefaultRestoreMaxPendingWrites bounds the number of writes Restore and
+// Checkpoint keep in flight while replaying a backup stream.
+const DefaultRestoreMaxPendingWrites = 256
+
+// Backup writes a portable snapshot of all versions committed at or after
+// since to w, using Badger's stream framework, and returns the highest
+// version it wrote. Passing that value plus one as since on the next call
+// produces a delta. Passing since as zero produces a full backup.
+func (d *Database) Backup(ctx context.Context, w io.Writer, since uint64) (uint64, error) {
+	return d.db.Backup(w, since)
+}
+
+// Restore replays a snapshot produced by Backup into the database. It does
+// not clear existing data first, so Restore into an empty database is the
+// common case; restoring on top of live data merges by key version.
+func (d *Database) Restore(ctx context.Context, r io.Reader) error {
+	return d.db.Load(r, DefaultRestoreMaxPendingWrites)
+}
+
+// Checkpoint takes a consistent point-in-time copy of the database's
+// on-disk state into dir. It streams a full Backup into a fresh Badger
+// instance opened at dir, analogous to how checkpoints are taken against
+// other embedded stores for historical versioning.
+func (d *Database) Checkpoint(ctx context.Context, dir string) error {
+	pr, pw := io.Pipe()
+
+	go func() {
+		_, err := d.db.Backup(pw, 0)
+		pw.CloseWithError(err)
+	}()
+
+	opts := badger.DefaultOptions(dir)
+	cdb, err := badger.Open(opts)
+	if err != nil {
+		pr.CloseWithError(err)
+		return err
+	}
+	defer cdb.Close()
+
+	return cdb.Load(pr, DefaultRestoreMaxPendingWrites)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("v"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := db.Backup(ctx, &buf, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := openTestDB(t)
+	if err := restored.Restore(ctx, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	assertValue(t, ctx, restored, "k", "v")
+}
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("v"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cpDir := filepath.Join(t.TempDir(), "checkpoint")
+	if err := db.Checkpoint(ctx, cpDir); err != nil {
+		t.Fatal(err)
+	}
+
+	cbdb, err := badger.Open(badger.DefaultOptions(cpDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cbdb.Close() })
+
+	assertValue(t, ctx, New(cbdb), "k", "v")
+}
+
+func assertValue(t *testing.T, ctx context.Context, db *Database, key, want string) {
+	t.Helper()
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		r, err := s.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if string(got) != want {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -20,6 +20,10 @@ import (
 
 type Database struct {
 	db *badger.DB
+
+	// MaxRetries limits how many times Update and View retry fn after a
+	// badger.ErrConflict before giving up. Zero selects DefaultMaxRetries.
+	MaxRetries int
 }
 
 // New returns a key-value database instance backed by the given badger
@@ -30,6 +34,83 @@ func New(db *badger.DB) *Database {
 	}
 }
 
+// DefaultMaxRetries is the number of times Update and View retry fn after a
+// badger.ErrConflict when Database.MaxRetries is left at zero.
+const DefaultMaxRetries = 10
+
+// Update runs fn inside a new read-write transaction and commits it. If the
+// commit fails with badger.ErrConflict, the transaction is discarded and the
+// attempt is retried with a fresh transaction, up to MaxRetries times.
+// reset is invoked before every attempt, including the first, so callers can
+// clear any external state -- maps, slices, accumulators -- that fn
+// populated during a failed attempt.
+func (d *Database) Update(ctx context.Context, reset func(), fn func(*Transaction) error) error {
+	max := d.MaxRetries
+	if max <= 0 {
+		max = DefaultMaxRetries
+	}
+
+	var err error
+	for i := 0; i <= max; i++ {
+		if reset != nil {
+			reset()
+		}
+
+		t, terr := d.NewTransaction(ctx)
+		if terr != nil {
+			return terr
+		}
+
+		if err = fn(t); err != nil {
+			t.Rollback(ctx)
+			return err
+		}
+
+		if err = t.Commit(ctx); err == nil {
+			return nil
+		}
+		if !errors.Is(err, badger.ErrConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+// View runs fn against a fresh read-only snapshot and discards the snapshot
+// afterwards. If fn returns a transient badger.ErrConflict error, the
+// snapshot is discarded and the attempt is retried with a fresh snapshot, up
+// to MaxRetries times. reset is invoked before every attempt, including the
+// first, so callers can clear any external state fn populated during a
+// failed attempt.
+func (d *Database) View(ctx context.Context, reset func(), fn func(*Snapshot) error) error {
+	max := d.MaxRetries
+	if max <= 0 {
+		max = DefaultMaxRetries
+	}
+
+	var err error
+	for i := 0; i <= max; i++ {
+		if reset != nil {
+			reset()
+		}
+
+		s, serr := d.NewSnapshot(ctx)
+		if serr != nil {
+			return serr
+		}
+
+		err = fn(s)
+		s.Discard(ctx)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, badger.ErrConflict) {
+			return err
+		}
+	}
+	return err
+}
+
 type Transaction struct {
 	*txn
 }
@@ -92,7 +173,11 @@ func (t *txn) discard(ctx context.Context) error {
 	return nil
 }
 
-// Get returns the value for a given key.
+// Get returns the value for a given key. The value is only copied out of
+// Badger's value log when the returned reader is actually read, so callers
+// that only need existence or metadata can skip the copy entirely. The
+// reader must be fully consumed before the transaction or snapshot it came
+// from is committed, rolled back, or discarded.
 func (t *txn) Get(ctx context.Context, k string) (io.Reader, error) {
 	item, err := t.tx.Get([]byte(k))
 	if err != nil {
@@ -101,11 +186,28 @@ func (t *txn) Get(ctx context.Context, k string) (io.Reader, error) {
 		}
 		return nil, err
 	}
-	v, err := item.ValueCopy(nil)
-	if err != nil {
-		return nil, err
+	return &valueReader{item: item}, nil
+}
+
+// valueReader lazily copies a Badger item's value out of the value log on
+// first Read, instead of eagerly materializing it as Get used to.
+type valueReader struct {
+	item *badger.Item
+	r    *bytes.Reader
+}
+
+func (r *valueReader) Read(p []byte) (int, error) {
+	if r.r == nil {
+		var data []byte
+		if err := r.item.Value(func(v []byte) error {
+			data = append([]byte(nil), v...)
+			return nil
+		}); err != nil {
+			return 0, err
+		}
+		r.r = bytes.NewReader(data)
 	}
-	return bytes.NewReader(v), nil
+	return r.r.Read(p)
 }
 
 // Set stores a key-value pair.
@@ -120,6 +222,22 @@ func (t *txn) Set(ctx context.Context, k string, v io.Reader) error {
 	return t.tx.Set([]byte(k), data)
 }
 
+// SetSize stores a key-value pair whose value is exactly size bytes long,
+// reading it from r into a single right-sized buffer instead of the
+// doubling reallocations io.ReadAll performs when the length is unknown.
+// This avoids an extra copy for the multi-MB values Badger's value log is
+// designed to hold efficiently.
+func (t *Transaction) SetSize(ctx context.Context, k string, size int64, r io.Reader) error {
+	if t.txn.db == nil {
+		return sql.ErrTxDone
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+	return t.txn.tx.Set([]byte(k), data)
+}
+
 // Delete removes the key-value pair with the given key.
 func (t *txn) Delete(ctx context.Context, k string) error {
 	if t.db == nil {
@@ -237,3 +355,106 @@ func (t *txn) Descend(ctx context.Context, beg, end string, errp *error) iter.Se
 		}
 	}
 }
+
+// prefixUpperBound returns the smallest key that is strictly greater than
+// every key having prefix, or nil if no such key exists because prefix is
+// empty or made up entirely of 0xFF bytes -- in which case the prefixed
+// range is unbounded above. A naive prefix+0xFF bound breaks as soon as a
+// real key extends past that single trailing byte (e.g. prefix "p" with
+// keys "p\xff" and "p\xffx"), which is the same class of bug
+// TestPrefixCleanupTrailingFF guards against for Descend.
+func prefixUpperBound(prefix []byte) []byte {
+	bound := append([]byte(nil), prefix...)
+	for i := len(bound) - 1; i >= 0; i-- {
+		if bound[i] != 0xff {
+			bound[i]++
+			return bound[:i+1]
+		}
+	}
+	return nil
+}
+
+// AscendPrefix returns key-value pairs with the given prefix through the
+// iterator, in ascending order. Unlike Ascend with end set to a
+// prefix-plus-0xff range, this sets Badger's IteratorOptions.Prefix, which
+// lets Badger skip entire SSTables using bloom filters instead of scanning
+// the full keyspace with a manual bounds check.
+func (t *txn) AscendPrefix(ctx context.Context, prefix string, errp *error) iter.Seq2[string, io.Reader] {
+	return func(yield func(string, io.Reader) bool) {
+		prefixBytes := []byte(prefix)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefixBytes
+
+		it := t.tx.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			key := string(item.KeyCopy(nil))
+
+			data, err := item.ValueCopy(nil)
+			if err != nil {
+				*errp = err
+				return
+			}
+			if !yield(key, bytes.NewReader(data)) {
+				return
+			}
+		}
+	}
+}
+
+// DescendPrefix returns key-value pairs with the given prefix through the
+// iterator, in descending order, using the same Badger Prefix optimization
+// as AscendPrefix. A prefix with no upper bound (empty or all 0xFF bytes)
+// is iterated without that optimization.
+func (t *txn) DescendPrefix(ctx context.Context, prefix string, errp *error) iter.Seq2[string, io.Reader] {
+	return func(yield func(string, io.Reader) bool) {
+		prefixBytes := []byte(prefix)
+		bound := prefixUpperBound(prefixBytes)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = true
+		// Rewind on an iterator with opts.Prefix seeks to the prefix itself,
+		// which in reverse would skip every longer key with the prefix, so
+		// the option is only set when there is a bound to Seek to instead.
+		if bound != nil {
+			opts.Prefix = prefixBytes
+		}
+
+		it := t.tx.NewIterator(opts)
+		defer it.Close()
+
+		// Seek past every key with this prefix, then walk backwards until a
+		// key no longer has the prefix. When the prefix has no upper bound
+		// (e.g. it's all 0xFF bytes), fall back to rewinding from the very
+		// last key in the database.
+		if bound != nil {
+			it.Seek(bound)
+		} else {
+			it.Rewind()
+		}
+
+		// If the bound itself is a key in the database, reverse Seek lands
+		// exactly on it. It doesn't have the prefix, so step past it once
+		// before checking ValidForPrefix in the loop condition below.
+		if it.Valid() && !it.ValidForPrefix(prefixBytes) {
+			it.Next()
+		}
+
+		for ; it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			key := string(item.KeyCopy(nil))
+
+			data, err := item.ValueCopy(nil)
+			if err != nil {
+				*errp = err
+				return
+			}
+			if !yield(key, bytes.NewReader(data)) {
+				return
+			}
+		}
+	}
+}
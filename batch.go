@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"io"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Batch queues Set and Delete operations in memory and writes them to disk
+// on Flush, bypassing the per-operation conflict detection a Transaction
+// pays for. Once the queued operations exceed what a single Badger
+// transaction can hold, that part is committed right away, before Flush.
+// It is meant for bulk loads -- initial imports,
+// migrations, log ingestion -- where NewTransaction/Commit is far slower and
+// more memory-hungry because every entry goes through the MVCC oracle.
+type Batch struct {
+	db *Database
+	wb *badger.WriteBatch
+}
+
+// NewBatch returns a new, empty Batch.
+func (d *Database) NewBatch(ctx context.Context) *Batch {
+	return &Batch{
+		db: d,
+		wb: d.db.NewWriteBatch(),
+	}
+}
+
+// Set queues a key-value pair to be written on the next Flush.
+func (b *Batch) Set(ctx context.Context, k string, v io.Reader) error {
+	data, err := io.ReadAll(v)
+	if err != nil {
+		return err
+	}
+	return b.wb.Set([]byte(k), data)
+}
+
+// Delete queues a key to be removed on the next Flush.
+func (b *Batch) Delete(ctx context.Context, k string) error {
+	return b.wb.Delete([]byte(k))
+}
+
+// Flush writes all queued operations to disk. The Batch can be reused for
+// further Set/Delete/Flush calls afterwards.
+func (b *Batch) Flush(ctx context.Context) error {
+	if err := b.wb.Flush(); err != nil {
+		return err
+	}
+	b.wb = b.db.db.NewWriteBatch()
+	return nil
+}
+
+// Commit is an alias for Flush, matching the naming Transaction uses
+// elsewhere in this package.
+func (b *Batch) Commit(ctx context.Context) error {
+	return b.Flush(ctx)
+}
+
+// Cancel discards any queued operations not yet written. Parts already
+// committed because the batch outgrew one transaction are not undone.
+func (b *Batch) Cancel(ctx context.Context) {
+	b.wb.Cancel()
+}
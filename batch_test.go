@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBatchFlushAndReuse(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	b := db.NewBatch(ctx)
+	if err := b.Set(ctx, "a", strings.NewReader("1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Set(ctx, "b", strings.NewReader("2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The batch must be reusable for further operations after Flush.
+	if err := b.Delete(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		if _, err := s.Get(ctx, "a"); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("got %v for deleted key, want os.ErrNotExist", err)
+		}
+
+		r, err := s.Get(ctx, "b")
+		if err != nil {
+			return err
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if string(got) != "2" {
+			t.Errorf("got %q, want %q", got, "2")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// openTestDB opens a fresh Badger-backed Database in a temporary directory
+// that is cleaned up when the test ends.
+func openTestDB(t *testing.T) *Database {
+	t.Helper()
+
+	dbDir := filepath.Join(t.TempDir(), "database")
+	bdb, err := badger.Open(badger.DefaultOptions(dbDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { bdb.Close() })
+
+	return New(bdb)
+}
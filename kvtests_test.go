@@ -93,7 +93,7 @@ func TestAllKeyValueTestsOverHTTP(t *testing.T) {
 	kvtests.TestTransactionDeleteVisibility(ctx, t, db)
 	kvtests.TestTransactionDeleteRecreate(ctx, t, db)
 	kvtests.TestTransactionRollbackVisibility(ctx, t, db)
-	// kvtests.TestLargeValueRoundtrip(ctx, t, db)
+	kvtests.TestLargeValueRoundtrip(ctx, t, db)
 	kvtests.TestZeroLengthValue(ctx, t, db)
 	kvtests.TestPrefixCleanupTrailingFF(ctx, t, db)
 }
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// SetOptions carries the extra per-entry settings Badger supports beyond a
+// plain key-value pair.
+type SetOptions struct {
+	// TTL, if positive, is how long the value remains valid for. It takes
+	// precedence over ExpiresAt when both are set.
+	TTL time.Duration
+
+	// ExpiresAt, if non-zero, is the absolute time the value stops being
+	// valid. Ignored when TTL is positive.
+	ExpiresAt time.Time
+
+	// Meta is an optional single byte of user metadata stored alongside
+	// the value and retrievable later through GetItem.
+	Meta byte
+}
+
+// SetWithOptions stores a key-value pair with a TTL, an absolute expiry, or
+// a byte of user metadata attached, using badger.Entry's WithTTL/WithMeta
+// instead of the plain Set path.
+func (t *Transaction) SetWithOptions(ctx context.Context, k string, v io.Reader, opts SetOptions) error {
+	if t.txn.db == nil {
+		return sql.ErrTxDone
+	}
+	data, err := io.ReadAll(v)
+	if err != nil {
+		return err
+	}
+
+	e := badger.NewEntry([]byte(k), data)
+	switch {
+	case opts.TTL > 0:
+		e = e.WithTTL(opts.TTL)
+	case !opts.ExpiresAt.IsZero():
+		e = e.WithTTL(time.Until(opts.ExpiresAt))
+	}
+	if opts.Meta != 0 {
+		e = e.WithMeta(opts.Meta)
+	}
+	return t.txn.tx.SetEntry(e)
+}
+
+// Item is a key-value pair along with the expiration and user metadata
+// GetItem exposes beyond what Get returns.
+type Item struct {
+	Key string
+
+	// Value is the item's value. It has the same lazy-copy behavior as the
+	// reader Get returns.
+	Value io.Reader
+
+	// ExpiresAt is the item's absolute expiration time, or the zero Time if
+	// the item has no TTL.
+	ExpiresAt time.Time
+
+	// Meta is the single byte of user metadata set via
+	// SetOptions.Meta, or zero if none was set.
+	Meta byte
+}
+
+// GetItem returns the key, value, expiration and user metadata for k.
+func (t *txn) GetItem(ctx context.Context, k string) (*Item, error) {
+	item, err := t.tx.Get([]byte(k))
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+
+	it := &Item{
+		Key:   k,
+		Value: &valueReader{item: item},
+		Meta:  item.UserMeta(),
+	}
+	if exp := item.ExpiresAt(); exp > 0 {
+		it.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+	return it, nil
+}
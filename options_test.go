@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetWithOptionsTTLAndMeta(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.SetWithOptions(ctx, "k", strings.NewReader("v"), SetOptions{
+			TTL:  time.Hour,
+			Meta: 0x7,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.View(ctx, nil, func(s *Snapshot) error {
+		item, err := s.GetItem(ctx, "k")
+		if err != nil {
+			return err
+		}
+		if item.Meta != 0x7 {
+			t.Errorf("got meta %#x, want %#x", item.Meta, 0x7)
+		}
+		if item.ExpiresAt.IsZero() {
+			t.Error("want a non-zero ExpiresAt for a TTL'd entry")
+		}
+		got, err := io.ReadAll(item.Value)
+		if err != nil {
+			return err
+		}
+		if string(got) != "v" {
+			t.Errorf("got value %q, want %q", got, "v")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetItemNoTTLHasZeroExpiry(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("v"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		item, err := s.GetItem(ctx, "k")
+		if err != nil {
+			return err
+		}
+		if !item.ExpiresAt.IsZero() {
+			t.Errorf("got ExpiresAt %v, want zero for an entry with no TTL", item.ExpiresAt)
+		}
+		if item.Meta != 0 {
+			t.Errorf("got meta %#x, want 0 for an entry with no SetOptions.Meta", item.Meta)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
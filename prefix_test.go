@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestAscendDescendPrefixTrailingFF mirrors TestPrefixCleanupTrailingFF:
+// keys extending past a prefix-plus-0xFF byte must not be dropped by
+// AscendPrefix/DescendPrefix.
+func TestAscendDescendPrefixTrailingFF(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	keys := []string{"p", "p\xff", "p\xffx", "q"}
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		for _, k := range keys {
+			if err := txn.Set(ctx, k, strings.NewReader(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		var errp error
+
+		var asc []string
+		for k := range s.AscendPrefix(ctx, "p", &errp) {
+			asc = append(asc, k)
+		}
+		if errp != nil {
+			return errp
+		}
+		if want := []string{"p", "p\xff", "p\xffx"}; !slices.Equal(asc, want) {
+			t.Errorf("AscendPrefix: got %q, want %q", asc, want)
+		}
+
+		var desc []string
+		for k := range s.DescendPrefix(ctx, "p", &errp) {
+			desc = append(desc, k)
+		}
+		if errp != nil {
+			return errp
+		}
+		if want := []string{"p\xffx", "p\xff", "p"}; !slices.Equal(desc, want) {
+			t.Errorf("DescendPrefix: got %q, want %q", desc, want)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDescendPrefixBoundIsExistingKey covers the plainer case where the
+// computed prefix upper bound happens to equal a real key in the database
+// (here "b", the bound for prefix "a"), which must not make DescendPrefix
+// stop before it starts.
+func TestDescendPrefixBoundIsExistingKey(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	keys := []string{"a", "az", "b"}
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		for _, k := range keys {
+			if err := txn.Set(ctx, k, strings.NewReader(k)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		var errp error
+
+		var desc []string
+		for k := range s.DescendPrefix(ctx, "a", &errp) {
+			desc = append(desc, k)
+		}
+		if errp != nil {
+			return errp
+		}
+		if want := []string{"az", "a"}; !slices.Equal(desc, want) {
+			t.Errorf("DescendPrefix: got %q, want %q", desc, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
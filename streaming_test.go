@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+)
+
+func TestSetSizeRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	want := bytes.Repeat([]byte("x"), 1<<20) // 1 MiB, large enough to land in the value log.
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.SetSize(ctx, "big", int64(len(want)), bytes.NewReader(want))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		r, err := s.Get(ctx, "big")
+		if err != nil {
+			return err
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("got %d bytes back, want %d", len(got), len(want))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetReaderIsLazy(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", bytes.NewReader([]byte("v")))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.View(ctx, nil, func(s *Snapshot) error {
+		r, err := s.Get(ctx, "k")
+		if err != nil {
+			return err
+		}
+		if _, ok := r.(*valueReader); !ok {
+			t.Fatalf("got %T, want *valueReader (lazy, uncopied until Read)", r)
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if string(got) != "v" {
+			t.Fatalf("got %q, want %q", got, "v")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/badger/v4/pb"
+)
+
+// Event describes a single key change delivered by Subscribe.
+type Event struct {
+	// Put is true for a create/update and false for a deletion.
+	//
+	// Badger's publisher only forwards an entry's caller-supplied UserMeta
+	// byte through pb.KV, never the internal tombstone bit Delete sets, so
+	// there is no reliable signal to tell a deletion apart from a Put of an
+	// empty value. Put is therefore a best-effort guess based on the value
+	// being empty; a legitimate empty-value Put is reported as Put == false
+	// too. Callers that must tell the two apart need to encode that in the
+	// value itself (or in SetOptions.Meta) rather than relying on Delete.
+	Put bool
+
+	// Key is the changed key.
+	Key string
+
+	// Value is the new value for a Put event; nil for a deletion.
+	Value io.Reader
+
+	// At is the commit timestamp the change was recorded at.
+	At uint64
+
+	// Err is set on the final Event sent before the channel is closed if
+	// the subscription ended for a reason other than ctx cancellation.
+	Err error
+}
+
+// Subscribe returns a channel of Events for every key committed under one
+// of the given prefixes once the subscription is registered with Badger.
+// Registration happens in a background goroutine after Subscribe returns,
+// so commits made right after the call can be missed. The
+// channel is closed when ctx is canceled or the subscription fails; in the
+// latter case the last Event sent carries the failure in Err.
+func (d *Database) Subscribe(ctx context.Context, prefixes [][]byte) (<-chan Event, error) {
+	matches := make([]pb.Match, len(prefixes))
+	for i, prefix := range prefixes {
+		matches[i] = pb.Match{Prefix: prefix}
+	}
+
+	ch := make(chan Event)
+
+	go func() {
+		defer close(ch)
+
+		err := d.db.Subscribe(ctx, func(kvs *badger.KVList) error {
+			for _, kv := range kvs.GetKv() {
+				ev := Event{
+					Key: string(kv.GetKey()),
+					At:  kv.GetVersion(),
+				}
+				if v := kv.GetValue(); len(v) > 0 {
+					ev.Put = true
+					ev.Value = bytes.NewReader(v)
+				}
+
+				select {
+				case ch <- ev:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return nil
+		}, matches)
+
+		if err != nil && !errors.Is(err, context.Canceled) {
+			ch <- Event{Err: err}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSubscribePutAndDelete documents the best-effort nature of Event.Put:
+// Badger's publisher never forwards the internal tombstone bit Delete sets,
+// so a deletion and a Put of an empty value are indistinguishable and both
+// surface as Put == false.
+func TestSubscribePutAndDelete(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := openTestDB(t)
+
+	ch, err := db.Subscribe(ctx, [][]byte{[]byte("k")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("v"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Delete(ctx, "k")
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	put := waitForEvent(t, ch)
+	if !put.Put || put.Key != "k" {
+		t.Fatalf("got %+v, want a Put event for key %q", put, "k")
+	}
+	gotValue, err := io.ReadAll(put.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(gotValue) != "v" {
+		t.Fatalf("got value %q, want %q", gotValue, "v")
+	}
+
+	del := waitForEvent(t, ch)
+	if del.Put || del.Key != "k" {
+		t.Fatalf("got %+v, want a non-Put event for key %q", del, "k")
+	}
+}
+
+func waitForEvent(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Subscribe event")
+		return Event{}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Visvasity LLC
+
+package kvbadger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+func TestUpdateRetriesOnConflict(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	if err := db.Update(ctx, nil, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("0"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	attempt := 0
+	raced := false
+	err := db.Update(ctx, func() { attempt++ }, func(txn *Transaction) error {
+		if _, err := txn.Get(ctx, "k"); err != nil {
+			return err
+		}
+
+		if !raced {
+			raced = true
+			// Commit a concurrent write to the same key so this
+			// transaction's own Commit below conflicts and gets retried.
+			other, err := db.NewTransaction(ctx)
+			if err != nil {
+				return err
+			}
+			if err := other.Set(ctx, "k", strings.NewReader("1")); err != nil {
+				return err
+			}
+			if err := other.Commit(ctx); err != nil {
+				return err
+			}
+		}
+
+		return txn.Set(ctx, "k", strings.NewReader("2"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempt < 2 {
+		t.Fatalf("want Update to retry at least once, got %d attempts", attempt)
+	}
+}
+
+func TestUpdateResetRunsEveryAttemptIncludingFirst(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	resets := 0
+	err := db.Update(ctx, func() { resets++ }, func(txn *Transaction) error {
+		return txn.Set(ctx, "k", strings.NewReader("v"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resets != 1 {
+		t.Fatalf("want reset called once for a successful first attempt, got %d", resets)
+	}
+}
+
+func TestViewRetriesAndGivesUp(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	db.MaxRetries = 2
+
+	resets := 0
+	err := db.View(ctx, func() { resets++ }, func(s *Snapshot) error {
+		return badger.ErrConflict
+	})
+	if !errors.Is(err, badger.ErrConflict) {
+		t.Fatalf("want ErrConflict, got %v", err)
+	}
+	if want := db.MaxRetries + 1; resets != want {
+		t.Fatalf("want %d resets, got %d", want, resets)
+	}
+}